@@ -1,9 +1,12 @@
 package bindroutes
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"path"
 	"reflect"
+	"regexp"
 	"strings"
 )
 
@@ -25,6 +28,23 @@ type (
 	// contains only the routing group path/pattern.
 	BasePath struct{}
 
+	// Method is an annotation type that marks a controller method (as
+	// opposed to a http.HandlerFunc field) as a route handler. Declare a
+	// field of this type with a handle tag and a method tag naming the
+	// target method, then define a method with that name and a
+	// (http.ResponseWriter, *http.Request) signature on the controller, so
+	// it can access the receiver's injected dependencies. The field needs
+	// its own name because Go doesn't allow a field and a method on the
+	// same struct to share one:
+	// <pre>
+	//
+	//	GetRoute Method `handle:"GET /{id}" method:"Get"`
+	//	...
+	//	func (c *UserController) Get(w http.ResponseWriter, r *http.Request) { ... }
+	//
+	// </pre>
+	Method struct{}
+
 	// plug maps methods (POST, GET, DELETE, etc) to a http.HandlerFunc
 	// cast as a reflect.Value.
 	plug map[string]reflect.Value
@@ -36,17 +56,242 @@ type (
 	}
 
 	handlerGroups map[string][]handler
+
+	// RouteInfo describes a single route discovered in a controller,
+	// without binding it to any router. It's what Describe and
+	// DescribeOpenAPI collect their output from.
+	RouteInfo struct {
+		Method         string
+		Path           string
+		RouterName     string
+		HandlerName    string
+		ControllerType string
+		Summary        string
+		Tags           []string
+		Produces       []string
+	}
+
+	// OpenAPIDocument is a minimal OpenAPI 3 document built from a set of
+	// controllers by DescribeOpenAPI. It only carries what the handle tags
+	// provide; fill in the rest (servers, schemas, security, ...) before
+	// serving it.
+	OpenAPIDocument struct {
+		OpenAPI string                                 `json:"openapi"`
+		Info    OpenAPIInfo                            `json:"info"`
+		Paths   map[string]map[string]OpenAPIOperation `json:"paths"`
+	}
+
+	OpenAPIInfo struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	OpenAPIOperation struct {
+		OperationID string   `json:"operationId,omitempty"`
+		Summary     string   `json:"summary,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+		Produces    []string `json:"produces,omitempty"`
+	}
+
+	// Middleware wraps a http.Handler with extra behaviour (auth, logging,
+	// recovery, etc). It follows the same signature used by most routers
+	// in the ecosystem (chi, alice) so existing middleware can be reused.
+	Middleware func(http.Handler) http.Handler
+
+	// PathSyntax translates the `{name}` path parameters used in handle
+	// tags into the wildcard syntax a particular router expects. Use one
+	// of the built-in syntaxes (SyntaxChi, SyntaxColon, SyntaxEcho) or
+	// supply a custom translator with WithPathSyntaxFunc.
+	PathSyntax struct {
+		translate func(string) string
+	}
+
+	// Option configures UsingRouter/UsingRouters beyond the controllers
+	// they bind. Pass one alongside the controllers, e.g.
+	// UsingRouter(r, WithPathSyntax(SyntaxColon), &ctrl).
+	Option interface {
+		apply(*bindConfig)
+	}
+
+	bindConfig struct {
+		translate func(string) string
+		strict    bool
+	}
+
+	strictOption struct{}
+
+	// BindError reports every problem UsingRouterE/UsingRoutersE found
+	// while binding, instead of panicking on the first one. It unwraps to
+	// the individual errors, so errors.Is(err, ErrUnknownRouter) etc. work
+	// against the aggregate.
+	BindError struct {
+		errs []error
+	}
+
+	pathSyntaxOption struct {
+		translate func(string) string
+	}
 )
 
 const (
 	RootRouterName = "root"
 
-	basePathTypeName  = "BasePath"
-	handleTagName     = "handle"
-	routerNameTagName = "using-router"
+	basePathTypeName   = "BasePath"
+	handleTagName      = "handle"
+	routerNameTagName  = "using-router"
+	middlewareTagName  = "middleware"
+	methodTagName      = "method"
+	subroutesFieldName = "Subroutes"
+	summaryTagName     = "summary"
+	tagsTagName        = "tags"
+	producesTagName    = "produces"
 )
 
-var basePathType = reflect.TypeOf(BasePath{})
+// Sentinel errors wrapped into a BindError by UsingRouterE/UsingRoutersE.
+// Test against them with errors.Is.
+var (
+	ErrUnknownMethod  = errors.New("bindroutes: unknown http method")
+	ErrMalformedTag   = errors.New("bindroutes: malformed handle tag")
+	ErrDuplicateRoute = errors.New("bindroutes: duplicate method and path")
+	ErrUnknownRouter  = errors.New("bindroutes: unknown router name")
+	ErrEmptyHandler   = errors.New("bindroutes: empty handler value")
+	ErrNoRoutes       = errors.New("bindroutes: controller contributed no routes")
+	ErrUnusedRouter   = errors.New("bindroutes: router name unused by any controller")
+)
+
+var (
+	basePathType     = reflect.TypeOf(BasePath{})
+	methodMarkerType = reflect.TypeOf(Method{})
+	handlerFuncType  = reflect.TypeOf(http.HandlerFunc(nil))
+	subroutesType    = reflect.TypeOf([]any(nil))
+
+	pathParamPattern = regexp.MustCompile(`\{(\*?)([^}]+)\}`)
+
+	// SyntaxChi keeps the `{name}` syntax used in handle tags unchanged;
+	// it matches chi and net/http 1.22's ServeMux.
+	SyntaxChi = PathSyntax{translate: func(p string) string { return p }}
+
+	// SyntaxColon translates `{name}` into `:name` and `{*name}` into
+	// `*name`, matching httprouter and gin.
+	SyntaxColon = PathSyntax{translate: func(p string) string {
+		return pathParamPattern.ReplaceAllStringFunc(p, func(m string) string {
+			sub := pathParamPattern.FindStringSubmatch(m)
+			if sub[1] == "*" {
+				return "*" + sub[2]
+			}
+			return ":" + sub[2]
+		})
+	}}
+
+	// SyntaxEcho translates `{name}` into `:name`, matching echo's named
+	// parameters, and collapses any catch-all `{*name}` into echo's
+	// anonymous `*` wildcard.
+	SyntaxEcho = PathSyntax{translate: func(p string) string {
+		return pathParamPattern.ReplaceAllStringFunc(p, func(m string) string {
+			sub := pathParamPattern.FindStringSubmatch(m)
+			if sub[1] == "*" {
+				return "*"
+			}
+			return ":" + sub[2]
+		})
+	}}
+)
+
+// WithPathSyntax selects one of the built-in path parameter syntaxes.
+func WithPathSyntax(s PathSyntax) Option {
+	return pathSyntaxOption{translate: s.translate}
+}
+
+// WithPathSyntaxFunc is the escape hatch for routers that don't match any
+// built-in PathSyntax: fn receives the `{name}`-style path produced by the
+// handle tags and returns the path in whatever syntax the router expects.
+func WithPathSyntaxFunc(fn func(string) string) Option {
+	return pathSyntaxOption{translate: fn}
+}
+
+func (o pathSyntaxOption) apply(c *bindConfig) {
+	c.translate = o.translate
+}
+
+// WithStrict makes UsingRouterE/UsingRoutersE also fail when a controller
+// contributes zero routes, and, for UsingRoutersE, when a router name in
+// the map passed in goes unused by every controller.
+func WithStrict() Option {
+	return strictOption{}
+}
+
+func (strictOption) apply(c *bindConfig) {
+	c.strict = true
+}
+
+// Error joins every accumulated error with "; ". Use errors.Is against one
+// of the Err* sentinels to test for a specific kind of failure.
+func (e *BindError) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("bindroutes: %d binding error(s): %s", len(e.errs), strings.Join(msgs, "; "))
+}
+
+func (e *BindError) Unwrap() []error {
+	return e.errs
+}
+
+func (e *BindError) add(err error) {
+	e.errs = append(e.errs, err)
+}
+
+func (e *BindError) errOrNil() error {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return e
+}
+
+// controllerValue returns v.Elem() for a non-nil controller pointer c, or
+// ok=false instead of panicking when c isn't one.
+func controllerValue(c any) (v reflect.Value, ok bool) {
+	rv := reflect.ValueOf(c)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return reflect.Value{}, false
+	}
+	return rv.Elem(), true
+}
+
+// splitHandleTagE is the non-panicking counterpart of splitHandleTag, used
+// by UsingRouterE/UsingRoutersE.
+func splitHandleTagE(tag string) (method, pattern string, err error) {
+	elems := strings.Split(tag, " ")
+	if len(elems) < 2 {
+		return "", "", fmt.Errorf("%w: %q", ErrMalformedTag, tag)
+	}
+
+	method, pattern = elems[0], elems[1]
+	if !isHTTPMethod(method) {
+		return "", "", fmt.Errorf("%w: %q", ErrUnknownMethod, method)
+	}
+	return method, pattern, nil
+}
+
+func newBindConfig() *bindConfig {
+	return &bindConfig{translate: SyntaxChi.translate}
+}
+
+// splitArgs pulls the Options out of a controllers... slice so the same
+// variadic parameter can carry both.
+func splitArgs(args []any) (*bindConfig, []any) {
+	cfg := newBindConfig()
+	controllers := make([]any, 0, len(args))
+	for _, a := range args {
+		if opt, ok := a.(Option); ok {
+			opt.apply(cfg)
+			continue
+		}
+		controllers = append(controllers, a)
+	}
+	return cfg, controllers
+}
 
 // Using binds all the handler funcs of each controller to a router method,
 // for instance: for the given controller function
@@ -56,16 +301,32 @@ var basePathType = reflect.TypeOf(BasePath{})
 //
 // </pre>
 // the result call will be r.Post("/something", controller.Post).
-func UsingRouter(r Router, controllers ...any) {
+func UsingRouter(r Router, args ...any) {
+	cfg, controllers := splitArgs(args)
+	p := routerPlug(r)
+	for _, c := range controllers {
+		v := reflect.ValueOf(c).Elem()
+		p.register(v, nil, cfg, "", nil)
+	}
+}
+
+// UsingRouterWithMiddleware behaves like UsingRouter, but wraps every
+// resulting http.HandlerFunc with the middleware named in the controller's
+// "middleware" tags. Middleware declared on the embedded BasePath is applied
+// to every field of that controller before the field's own middleware, so
+// group-level middleware always runs on the outside.
+func UsingRouterWithMiddleware(r Router, mws map[string]Middleware, args ...any) {
+	cfg, controllers := splitArgs(args)
 	p := routerPlug(r)
 	for _, c := range controllers {
 		v := reflect.ValueOf(c).Elem()
-		p.register(v)
+		p.register(v, mws, cfg, "", nil)
 	}
 }
 
-func UsingRouters(rs map[string]Router, controllers ...any) {
-	gs := groupHandlerFuncs(controllers)
+func UsingRouters(rs map[string]Router, args ...any) {
+	cfg, controllers := splitArgs(args)
+	gs := groupHandlerFuncs(controllers, nil, cfg)
 	for name, r := range rs {
 		p := routerPlug(r)
 		g, ok := gs[name]
@@ -75,15 +336,216 @@ func UsingRouters(rs map[string]Router, controllers ...any) {
 	}
 }
 
-func (p plug) register(v reflect.Value) {
+// UsingRoutersWithMiddleware behaves like UsingRouters, applying the named
+// middleware the same way UsingRouterWithMiddleware does.
+func UsingRoutersWithMiddleware(rs map[string]Router, mws map[string]Middleware, args ...any) {
+	cfg, controllers := splitArgs(args)
+	gs := groupHandlerFuncs(controllers, mws, cfg)
+	for name, r := range rs {
+		p := routerPlug(r)
+		g, ok := gs[name]
+		if ok {
+			p.registerGroup(g)
+		}
+	}
+}
+
+// UsingRouterE behaves like UsingRouter, but never panics: malformed tags,
+// unknown methods, duplicate routes and empty handler values are collected
+// into a returned BindError instead. With WithStrict, a controller that
+// contributes zero routes is reported too.
+func UsingRouterE(r Router, args ...any) error {
+	cfg, controllers := splitArgs(args)
+	p := routerPlug(r)
+	be := &BindError{}
+	seen := make(map[string]bool)
+
+	for _, c := range controllers {
+		v, ok := controllerValue(c)
+		if !ok {
+			be.add(fmt.Errorf("%w: controller %T is not a non-nil pointer", ErrEmptyHandler, c))
+			continue
+		}
+
+		contributed := p.registerE(v, nil, cfg, "", nil, seen, be)
+		if cfg.strict && !contributed {
+			be.add(fmt.Errorf("%w: %s", ErrNoRoutes, v.Type()))
+		}
+	}
+
+	return be.errOrNil()
+}
+
+// UsingRoutersE behaves like UsingRouters, but never panics: the same
+// validation UsingRouterE performs also checks that every using-router tag
+// names a router present in rs. With WithStrict, a router name in rs that
+// no controller used is reported too.
+func UsingRoutersE(rs map[string]Router, args ...any) error {
+	cfg, controllers := splitArgs(args)
+	be := &BindError{}
+	seen := make(map[string]bool)
+	used := make(map[string]bool)
+
+	gs := make(handlerGroups)
+	for _, c := range controllers {
+		v, ok := controllerValue(c)
+		if !ok {
+			be.add(fmt.Errorf("%w: controller %T is not a non-nil pointer", ErrEmptyHandler, c))
+			continue
+		}
+
+		contributed := gs.collectE(v, nil, cfg, "", nil, rs, seen, used, be)
+		if cfg.strict && !contributed {
+			be.add(fmt.Errorf("%w: %s", ErrNoRoutes, v.Type()))
+		}
+	}
+
+	for name, r := range rs {
+		p := routerPlug(r)
+		if g, ok := gs[name]; ok {
+			p.registerGroup(g)
+		}
+	}
+
+	if cfg.strict {
+		for name := range rs {
+			if !used[name] {
+				be.add(fmt.Errorf("%w: %s", ErrUnusedRouter, name))
+			}
+		}
+	}
+
+	return be.errOrNil()
+}
+
+// Describe performs the same reflection walk as UsingRouter, but instead of
+// binding each route to a router it collects a RouteInfo for it, making the
+// handle tags a single source of truth for both binding and documentation.
+// Pass the same Options (e.g. WithPathSyntax) used with UsingRouter/
+// UsingRouters so the reported paths match what was actually registered.
+func Describe(args ...any) []RouteInfo {
+	cfg, controllers := splitArgs(args)
+	var infos []RouteInfo
+	for _, c := range controllers {
+		v := reflect.ValueOf(c).Elem()
+		describeWalk(v, cfg, "", &infos)
+	}
+	return infos
+}
+
+// DescribeOpenAPI builds a minimal OpenAPI 3 document from the same routes
+// Describe reports. It accepts the same arguments as Describe.
+func DescribeOpenAPI(args ...any) OpenAPIDocument {
+	doc := OpenAPIDocument{
+		OpenAPI: "3.0.0",
+		Info:    OpenAPIInfo{Title: "API", Version: "1.0.0"},
+		Paths:   make(map[string]map[string]OpenAPIOperation),
+	}
+
+	for _, info := range Describe(args...) {
+		ops, ok := doc.Paths[info.Path]
+		if !ok {
+			ops = make(map[string]OpenAPIOperation)
+			doc.Paths[info.Path] = ops
+		}
+		ops[strings.ToLower(info.Method)] = OpenAPIOperation{
+			OperationID: info.HandlerName,
+			Summary:     info.Summary,
+			Tags:        info.Tags,
+			Produces:    info.Produces,
+		}
+	}
+	return doc
+}
+
+// describeWalk mirrors handlerGroups.collect, but appends a RouteInfo per
+// route instead of binding it, recursing into Subroutes the same way and
+// translating each path through cfg the same way register/collect do, so
+// the reported path matches what was actually bound.
+func describeWalk(v reflect.Value, cfg *bindConfig, prefix string, infos *[]RouteInfo) {
 	fields := reflect.VisibleFields(v.Type())
-	bpath := basePath(fields)
+	bpath := path.Join(prefix, basePath(fields))
 
 	for i, f := range fields {
 		if isGroupAnnotation(f) {
 			continue
 		}
 
+		if isSubroutesField(f) {
+			subroutes := v.FieldByIndex([]int{i})
+			for j := 0; j < subroutes.Len(); j++ {
+				child := reflect.ValueOf(subroutes.Index(j).Interface()).Elem()
+				describeWalk(child, cfg, bpath, infos)
+			}
+			continue
+		}
+
+		tag := f.Tag.Get(handleTagName)
+		if tag == "" {
+			continue
+		}
+
+		if _, ok := resolvedHandler(v, f, i); !ok {
+			continue
+		}
+
+		routerName := f.Tag.Get(routerNameTagName)
+		if routerName == "" {
+			routerName = RootRouterName
+		}
+
+		method, pattern := splitHandleTag(tag)
+		*infos = append(*infos, RouteInfo{
+			Method:         method,
+			Path:           cfg.translate(path.Join(bpath, pattern)),
+			RouterName:     routerName,
+			HandlerName:    f.Name,
+			ControllerType: v.Type().String(),
+			Summary:        f.Tag.Get(summaryTagName),
+			Tags:           splitTagList(f.Tag.Get(tagsTagName)),
+			Produces:       splitTagList(f.Tag.Get(producesTagName)),
+		})
+	}
+}
+
+// splitTagList parses a comma separated struct tag value, e.g. the "tags"
+// or "produces" tags, the same way middlewareNames parses "middleware".
+func splitTagList(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+
+	raw := strings.Split(tag, ",")
+	items := make([]string, 0, len(raw))
+	for _, it := range raw {
+		it = strings.TrimSpace(it)
+		if it != "" {
+			items = append(items, it)
+		}
+	}
+	return items
+}
+
+// register binds every handler declared on v, joining each route under
+// prefix, which is the concatenation of every ancestor's BasePath reached
+// so far through Subroutes. groupMws carries the group-level middleware
+// accumulated from every ancestor's BasePath, outermost first, so it keeps
+// wrapping the routes of controllers reached through Subroutes.
+func (p plug) register(v reflect.Value, mws map[string]Middleware, cfg *bindConfig, prefix string, groupMws []string) {
+	fields := reflect.VisibleFields(v.Type())
+	bpath := path.Join(prefix, basePath(fields))
+	bmws := append(append([]string{}, groupMws...), basePathMiddleware(fields)...)
+
+	for i, f := range fields {
+		if isGroupAnnotation(f) {
+			continue
+		}
+
+		if isSubroutesField(f) {
+			p.registerSubroutes(v.FieldByIndex([]int{i}), mws, cfg, bpath, bmws)
+			continue
+		}
+
 		tag := f.Tag.Get(handleTagName)
 		if tag == "" {
 			continue
@@ -95,15 +557,104 @@ func (p plug) register(v reflect.Value) {
 			continue
 		}
 
-		urlPath := path.Join(bpath, pattern)
+		h, ok := resolvedHandler(v, f, i)
+		if !ok {
+			continue
+		}
+
+		urlPath := cfg.translate(path.Join(bpath, pattern))
+		fieldHandler := wrapFieldMiddleware(h, bmws, middlewareNames(f), mws)
 		in := []reflect.Value{
 			reflect.ValueOf(urlPath),
-			v.FieldByIndex([]int{i}),
+			fieldHandler,
 		}
 		handle.Call(in)
 	}
 }
 
+// registerSubroutes recurses into each controller referenced by a
+// Subroutes field, binding its routes under prefix and wrapped by the
+// group middleware accumulated so far.
+func (p plug) registerSubroutes(subroutes reflect.Value, mws map[string]Middleware, cfg *bindConfig, prefix string, groupMws []string) {
+	for i := 0; i < subroutes.Len(); i++ {
+		child := reflect.ValueOf(subroutes.Index(i).Interface()).Elem()
+		p.register(child, mws, cfg, prefix, groupMws)
+	}
+}
+
+// registerE mirrors register, but reports problems to be instead of
+// panicking, and skips only the offending route rather than the whole
+// controller. groupMws carries the group-level middleware accumulated from
+// every ancestor's BasePath, outermost first, the same way register's does.
+// It returns whether it registered at least one route.
+func (p plug) registerE(v reflect.Value, mws map[string]Middleware, cfg *bindConfig, prefix string, groupMws []string, seen map[string]bool, be *BindError) bool {
+	fields := reflect.VisibleFields(v.Type())
+	bpath := path.Join(prefix, basePath(fields))
+	bmws := append(append([]string{}, groupMws...), basePathMiddleware(fields)...)
+	contributed := false
+
+	for i, f := range fields {
+		if isGroupAnnotation(f) {
+			continue
+		}
+
+		if isSubroutesField(f) {
+			subroutes := v.FieldByIndex([]int{i})
+			for j := 0; j < subroutes.Len(); j++ {
+				child, ok := controllerValue(subroutes.Index(j).Interface())
+				if !ok {
+					be.add(fmt.Errorf("%w: subroute %d of %s is not a non-nil pointer", ErrEmptyHandler, j, v.Type()))
+					continue
+				}
+				if p.registerE(child, mws, cfg, bpath, bmws, seen, be) {
+					contributed = true
+				}
+			}
+			continue
+		}
+
+		tag := f.Tag.Get(handleTagName)
+		if tag == "" {
+			continue
+		}
+
+		method, pattern, err := splitHandleTagE(tag)
+		if err != nil {
+			be.add(fmt.Errorf("%s.%s: %w", v.Type(), f.Name, err))
+			continue
+		}
+
+		handle, ok := p[method]
+		if !ok {
+			be.add(fmt.Errorf("%s.%s: %w: %s", v.Type(), f.Name, ErrUnknownMethod, method))
+			continue
+		}
+
+		h, ok := resolvedHandler(v, f, i)
+		if !ok || h.IsNil() {
+			be.add(fmt.Errorf("%s.%s: %w", v.Type(), f.Name, ErrEmptyHandler))
+			continue
+		}
+
+		urlPath := cfg.translate(path.Join(bpath, pattern))
+		key := method + " " + urlPath
+		if seen[key] {
+			be.add(fmt.Errorf("%w: %s", ErrDuplicateRoute, key))
+			continue
+		}
+		seen[key] = true
+
+		fieldHandler := wrapFieldMiddleware(h, bmws, middlewareNames(f), mws)
+		in := []reflect.Value{
+			reflect.ValueOf(urlPath),
+			fieldHandler,
+		}
+		handle.Call(in)
+		contributed = true
+	}
+	return contributed
+}
+
 func (p plug) registerGroup(hs []handler) {
 	for _, h := range hs {
 		method, ok := p[h.method]
@@ -122,6 +673,44 @@ func isGroupAnnotation(f reflect.StructField) bool {
 	return f.Name == basePathTypeName && f.Type == basePathType
 }
 
+// isSubroutesField recognizes the `Subroutes []any` convention: a field
+// holding pointers to child controllers that should be mounted under the
+// enclosing controller's BasePath, the way chi's Mount or gin's nested
+// Group compose route groups.
+func isSubroutesField(f reflect.StructField) bool {
+	return f.Name == subroutesFieldName && f.Type == subroutesType
+}
+
+// resolvedHandler returns the http.HandlerFunc bound to field i of v. For a
+// http.HandlerFunc field it's the field's own value; for a Method marker
+// field it's the controller's method named by the field's method tag, bound
+// to v so it can reach the receiver's dependencies. ok is false when neither
+// applies.
+func resolvedHandler(v reflect.Value, f reflect.StructField, i int) (reflect.Value, bool) {
+	if f.Type == handlerFuncType {
+		return v.FieldByIndex([]int{i}), true
+	}
+
+	if f.Type == methodMarkerType {
+		name := f.Tag.Get(methodTagName)
+		if name == "" {
+			return reflect.Value{}, false
+		}
+
+		m := v.Addr().MethodByName(name)
+		if !m.IsValid() {
+			return reflect.Value{}, false
+		}
+		fn, ok := m.Interface().(func(http.ResponseWriter, *http.Request))
+		if !ok {
+			return reflect.Value{}, false
+		}
+		return reflect.ValueOf(http.HandlerFunc(fn)), true
+	}
+
+	return reflect.Value{}, false
+}
+
 func basePath(fields []reflect.StructField) string {
 	for _, f := range fields {
 		if isGroupAnnotation(f) {
@@ -131,6 +720,55 @@ func basePath(fields []reflect.StructField) string {
 	return ""
 }
 
+// basePathMiddleware returns the middleware names declared on the embedded
+// BasePath annotation, i.e. the group-level middleware chain.
+func basePathMiddleware(fields []reflect.StructField) []string {
+	for _, f := range fields {
+		if isGroupAnnotation(f) {
+			return middlewareNames(f)
+		}
+	}
+	return nil
+}
+
+// middlewareNames parses the comma separated list of middleware names
+// declared in a field's "middleware" tag.
+func middlewareNames(f reflect.StructField) []string {
+	return splitTagList(f.Tag.Get(middlewareTagName))
+}
+
+// wrapFieldMiddleware wraps the http.HandlerFunc held by v with the
+// middleware named in groupNames and fieldNames, group-level middleware
+// applied outermost. v is returned unchanged when there's nothing to wrap
+// or when it isn't a http.HandlerFunc.
+func wrapFieldMiddleware(v reflect.Value, groupNames, fieldNames []string, mws map[string]Middleware) reflect.Value {
+	if len(groupNames) == 0 && len(fieldNames) == 0 {
+		return v
+	}
+
+	h, ok := v.Interface().(http.HandlerFunc)
+	if !ok {
+		return v
+	}
+
+	names := append(append([]string{}, groupNames...), fieldNames...)
+	return reflect.ValueOf(chainMiddleware(h, names, mws))
+}
+
+// chainMiddleware composes the named middleware around h, the first name
+// ending up as the outermost handler.
+func chainMiddleware(h http.HandlerFunc, names []string, mws map[string]Middleware) http.HandlerFunc {
+	var handler http.Handler = h
+	for i := len(names) - 1; i >= 0; i-- {
+		mw, ok := mws[names[i]]
+		if !ok {
+			continue
+		}
+		handler = mw(handler)
+	}
+	return handler.ServeHTTP
+}
+
 func splitHandleTag(tag string) (method, pattern string) {
 	elems := strings.Split(tag, " ")
 	if len(elems) < 2 {
@@ -154,33 +792,139 @@ func isHTTPMethod(m string) bool {
 		strings.EqualFold(m, "put")
 }
 
-func groupHandlerFuncs(controllers []any) handlerGroups {
+func groupHandlerFuncs(controllers []any, mws map[string]Middleware, cfg *bindConfig) handlerGroups {
 	g := make(handlerGroups)
 	for _, c := range controllers {
 		v := reflect.ValueOf(c).Elem()
-		fields := reflect.VisibleFields(v.Type())
-		bpath := basePath(fields)
-		for i, f := range fields {
-			if isGroupAnnotation(f) {
-				continue
+		g.collect(v, mws, cfg, "", nil)
+	}
+	return g
+}
+
+// collect walks v's fields, adding every declared route under prefix, and
+// recurses into any Subroutes field so nested controllers contribute to
+// the same handlerGroups. groupMws carries the group-level middleware
+// accumulated from every ancestor's BasePath, outermost first, so it keeps
+// wrapping the routes of controllers reached through Subroutes.
+func (g handlerGroups) collect(v reflect.Value, mws map[string]Middleware, cfg *bindConfig, prefix string, groupMws []string) {
+	fields := reflect.VisibleFields(v.Type())
+	bpath := path.Join(prefix, basePath(fields))
+	bmws := append(append([]string{}, groupMws...), basePathMiddleware(fields)...)
+
+	for i, f := range fields {
+		if isGroupAnnotation(f) {
+			continue
+		}
+
+		if isSubroutesField(f) {
+			subroutes := v.FieldByIndex([]int{i})
+			for j := 0; j < subroutes.Len(); j++ {
+				child := reflect.ValueOf(subroutes.Index(j).Interface()).Elem()
+				g.collect(child, mws, cfg, bpath, bmws)
 			}
+			continue
+		}
 
-			tag := f.Tag.Get(handleTagName)
-			if tag == "" {
-				continue
+		tag := f.Tag.Get(handleTagName)
+		if tag == "" {
+			continue
+		}
+
+		routerName := f.Tag.Get(routerNameTagName)
+		if routerName == "" {
+			routerName = RootRouterName
+		}
+
+		h, ok := resolvedHandler(v, f, i)
+		if !ok {
+			continue
+		}
+
+		method, pattern := splitHandleTag(tag)
+		route := cfg.translate(path.Join(bpath, pattern))
+		fieldHandler := wrapFieldMiddleware(h, bmws, middlewareNames(f), mws)
+		g.add(routerName, method, route, fieldHandler)
+	}
+}
+
+// collectE mirrors collect, but reports problems to be instead of
+// panicking, additionally validating that every explicit using-router tag
+// names an entry in rs. groupMws carries the group-level middleware
+// accumulated from every ancestor's BasePath, outermost first, the same way
+// collect's does. It returns whether it collected at least one route.
+func (g handlerGroups) collectE(v reflect.Value, mws map[string]Middleware, cfg *bindConfig, prefix string, groupMws []string, rs map[string]Router, seen, used map[string]bool, be *BindError) bool {
+	fields := reflect.VisibleFields(v.Type())
+	bpath := path.Join(prefix, basePath(fields))
+	bmws := append(append([]string{}, groupMws...), basePathMiddleware(fields)...)
+	contributed := false
+
+	for i, f := range fields {
+		if isGroupAnnotation(f) {
+			continue
+		}
+
+		if isSubroutesField(f) {
+			subroutes := v.FieldByIndex([]int{i})
+			for j := 0; j < subroutes.Len(); j++ {
+				child, ok := controllerValue(subroutes.Index(j).Interface())
+				if !ok {
+					be.add(fmt.Errorf("%w: subroute %d of %s is not a non-nil pointer", ErrEmptyHandler, j, v.Type()))
+					continue
+				}
+				if g.collectE(child, mws, cfg, bpath, bmws, rs, seen, used, be) {
+					contributed = true
+				}
 			}
+			continue
+		}
 
-			routerName := f.Tag.Get(routerNameTagName)
-			if routerName == "" {
-				routerName = RootRouterName
+		tag := f.Tag.Get(handleTagName)
+		if tag == "" {
+			continue
+		}
+
+		explicitRouterName := f.Tag.Get(routerNameTagName)
+		if explicitRouterName != "" {
+			if _, ok := rs[explicitRouterName]; !ok {
+				be.add(fmt.Errorf("%s.%s: %w: %s", v.Type(), f.Name, ErrUnknownRouter, explicitRouterName))
+				continue
 			}
+		}
+		routerName := explicitRouterName
+		if routerName == "" {
+			routerName = RootRouterName
+		}
+
+		method, pattern, err := splitHandleTagE(tag)
+		if err != nil {
+			be.add(fmt.Errorf("%s.%s: %w", v.Type(), f.Name, err))
+			continue
+		}
+		if method != strings.ToUpper(method) {
+			be.add(fmt.Errorf("%s.%s: %w: %s", v.Type(), f.Name, ErrUnknownMethod, method))
+			continue
+		}
 
-			method, pattern := splitHandleTag(tag)
-			route := path.Join(bpath, pattern)
-			g.add(routerName, method, route, v.FieldByIndex([]int{i}))
+		h, ok := resolvedHandler(v, f, i)
+		if !ok || h.IsNil() {
+			be.add(fmt.Errorf("%s.%s: %w", v.Type(), f.Name, ErrEmptyHandler))
+			continue
 		}
+
+		route := cfg.translate(path.Join(bpath, pattern))
+		key := routerName + " " + method + " " + route
+		if seen[key] {
+			be.add(fmt.Errorf("%w: %s", ErrDuplicateRoute, key))
+			continue
+		}
+		seen[key] = true
+		used[routerName] = true
+
+		fieldHandler := wrapFieldMiddleware(h, bmws, middlewareNames(f), mws)
+		g.add(routerName, method, route, fieldHandler)
+		contributed = true
 	}
-	return g
+	return contributed
 }
 
 func (g handlerGroups) add(key, method, path string, h reflect.Value) {
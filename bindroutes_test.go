@@ -2,6 +2,8 @@ package bindroutes
 
 import (
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -10,6 +12,11 @@ import (
 type (
 	testRouter map[string]bool
 
+	// capturingRouter records the bound http.HandlerFunc itself, rather
+	// than just the fact that binding happened, so tests can invoke it
+	// and observe middleware side effects.
+	capturingRouter map[string]http.HandlerFunc
+
 	testHandler struct {
 		BasePath `handle:"/users"`
 
@@ -32,8 +39,68 @@ type (
 	failingHandler struct {
 		Post http.HandlerFunc `handle:"Get,/users"`
 	}
+
+	testMiddlewareHandler struct {
+		BasePath `handle:"/users" middleware:"group"`
+
+		Post http.HandlerFunc `handle:"POST /" middleware:"field"`
+		Get  http.HandlerFunc `handle:"GET /{id}"`
+	}
+
+	testMethodController struct {
+		BasePath `handle:"/users"`
+
+		deps string
+
+		GetRoute Method           `handle:"GET /{id}" method:"Get"`
+		Post     http.HandlerFunc `handle:"POST /"`
+	}
+
+	testPostsController struct {
+		BasePath `handle:"/{id}/posts"`
+
+		Get http.HandlerFunc `handle:"GET /"`
+	}
+
+	testUsersController struct {
+		BasePath `handle:"/users" middleware:"auth"`
+
+		Get       http.HandlerFunc `handle:"GET /{id}"`
+		Subroutes []any
+	}
+
+	testDescribeController struct {
+		BasePath `handle:"/users"`
+
+		Get http.HandlerFunc `handle:"GET /{id}" summary:"Get a user" tags:"users" produces:"application/json"`
+	}
+
+	testFilesController struct {
+		BasePath `handle:"/files"`
+
+		Get http.HandlerFunc `handle:"GET /{*path}"`
+	}
+
+	testDuplicateController struct {
+		BasePath `handle:"/a"`
+
+		First  http.HandlerFunc `handle:"GET /"`
+		Second http.HandlerFunc `handle:"GET /"`
+	}
+
+	testEmptyController struct {
+		BasePath `handle:"/empty"`
+	}
+
+	testUnknownRouterController struct {
+		Post http.HandlerFunc `handle:"POST /" using-router:"ghost"`
+	}
 )
 
+func (c *testMethodController) Get(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("deps", c.deps)
+}
+
 func TestSplitTag(t *testing.T) {
 	assert.Panics(
 		t,
@@ -115,13 +182,274 @@ func TestGroupHandlerFuncs(t *testing.T) {
 		Delete: dummyHandler,
 	}
 
-	hg := groupHandlerFuncs([]any{&h})
+	hg := groupHandlerFuncs([]any{&h}, nil, newBindConfig())
 	assert.Equal(t, 2, len(hg["router_a"]))
 	assert.Equal(t, 1, len(hg["router_b"]))
 	assert.Equal(t, 1, len(hg["router_c"]))
 	assert.Equal(t, 0, len(hg["router_d"]))
 }
 
+func TestUsingRouterWithMiddleware(t *testing.T) {
+	var order []string
+
+	track := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	h := testMiddlewareHandler{
+		Post: dummyHandler,
+		Get:  dummyHandler,
+	}
+	r := make(capturingRouter)
+	mws := map[string]Middleware{
+		"group": track("group"),
+		"field": track("field"),
+	}
+
+	UsingRouterWithMiddleware(r, mws, &h)
+
+	post := r["POST /users"]
+	assert.NotNil(t, post)
+	post(nil, httptest.NewRequest(http.MethodPost, "/users", nil))
+	assert.Equal(t, []string{"group", "field"}, order)
+
+	get := r["GET /users/{id}"]
+	assert.NotNil(t, get)
+	order = nil
+	get(nil, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	assert.Equal(t, []string{"group"}, order)
+}
+
+func TestUsingRouterWithPathSyntax(t *testing.T) {
+	h := testHandler{
+		Post:   dummyHandler,
+		Get:    dummyHandler,
+		Put:    dummyHandler,
+		Delete: dummyHandler,
+	}
+	r := make(testRouter)
+
+	UsingRouter(r, WithPathSyntax(SyntaxColon), &h)
+
+	assert.True(t, r["POST /users"])
+	assert.True(t, r["GET /users/:id"])
+	assert.True(t, r["PUT /users/:id"])
+	assert.True(t, r["DELETE /users/:id"])
+}
+
+func TestUsingRouterWithPathSyntaxFunc(t *testing.T) {
+	h := testHandler{
+		Post:   dummyHandler,
+		Get:    dummyHandler,
+		Put:    dummyHandler,
+		Delete: dummyHandler,
+	}
+	r := make(testRouter)
+
+	UsingRouter(r, WithPathSyntaxFunc(strings.ToUpper), &h)
+
+	assert.True(t, r["GET /USERS/{ID}"])
+}
+
+func TestUsingRouterWithPathSyntaxCatchAll(t *testing.T) {
+	h := testFilesController{Get: dummyHandler}
+	r := make(testRouter)
+
+	UsingRouter(r, WithPathSyntax(SyntaxColon), &h)
+
+	assert.True(t, r["GET /files/*path"])
+}
+
+func TestUsingRouterWithEchoSyntax(t *testing.T) {
+	h := testHandler{
+		Post:   dummyHandler,
+		Get:    dummyHandler,
+		Put:    dummyHandler,
+		Delete: dummyHandler,
+	}
+	r := make(testRouter)
+
+	UsingRouter(r, WithPathSyntax(SyntaxEcho), &h)
+
+	assert.True(t, r["POST /users"])
+	assert.True(t, r["GET /users/:id"])
+	assert.True(t, r["PUT /users/:id"])
+	assert.True(t, r["DELETE /users/:id"])
+}
+
+func TestUsingRouterWithEchoSyntaxCatchAll(t *testing.T) {
+	h := testFilesController{Get: dummyHandler}
+	r := make(testRouter)
+
+	UsingRouter(r, WithPathSyntax(SyntaxEcho), &h)
+
+	assert.True(t, r["GET /files/*"])
+}
+
+func TestUsingRouterSubroutes(t *testing.T) {
+	posts := testPostsController{Get: dummyHandler}
+	users := testUsersController{
+		Get:       dummyHandler,
+		Subroutes: []any{&posts},
+	}
+	r := make(testRouter)
+
+	UsingRouter(r, &users)
+
+	assert.True(t, r["GET /users/{id}"])
+	assert.True(t, r["GET /users/{id}/posts"])
+}
+
+func TestUsingRouterSubroutesInheritGroupMiddleware(t *testing.T) {
+	var order []string
+
+	track := func(name string) Middleware {
+		return func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				order = append(order, name)
+				next.ServeHTTP(w, r)
+			})
+		}
+	}
+
+	posts := testPostsController{Get: dummyHandler}
+	users := testUsersController{
+		Get:       dummyHandler,
+		Subroutes: []any{&posts},
+	}
+	r := make(capturingRouter)
+	mws := map[string]Middleware{"auth": track("auth")}
+
+	UsingRouterWithMiddleware(r, mws, &users)
+
+	postsGet := r["GET /users/{id}/posts"]
+	assert.NotNil(t, postsGet)
+	postsGet(nil, httptest.NewRequest(http.MethodGet, "/users/1/posts", nil))
+	assert.Equal(t, []string{"auth"}, order)
+}
+
+func TestDescribe(t *testing.T) {
+	h := testDescribeController{Get: dummyHandler}
+
+	infos := Describe(&h)
+
+	assert.Len(t, infos, 1)
+	assert.Equal(t, "GET", infos[0].Method)
+	assert.Equal(t, "/users/{id}", infos[0].Path)
+	assert.Equal(t, "Get a user", infos[0].Summary)
+	assert.Equal(t, []string{"users"}, infos[0].Tags)
+	assert.Equal(t, []string{"application/json"}, infos[0].Produces)
+}
+
+func TestDescribeWithPathSyntax(t *testing.T) {
+	h := testDescribeController{Get: dummyHandler}
+
+	infos := Describe(WithPathSyntax(SyntaxColon), &h)
+
+	assert.Len(t, infos, 1)
+	assert.Equal(t, "/users/:id", infos[0].Path)
+}
+
+func TestDescribeOpenAPI(t *testing.T) {
+	h := testDescribeController{Get: dummyHandler}
+
+	doc := DescribeOpenAPI(&h)
+
+	op, ok := doc.Paths["/users/{id}"]["get"]
+	assert.True(t, ok)
+	assert.Equal(t, "Get a user", op.Summary)
+	assert.Equal(t, []string{"users"}, op.Tags)
+}
+
+func TestUsingRouterMethodController(t *testing.T) {
+	c := testMethodController{deps: "db-conn", Post: dummyHandler}
+	r := make(capturingRouter)
+
+	UsingRouter(r, &c)
+
+	get := r["GET /users/{id}"]
+	assert.NotNil(t, get)
+
+	w := httptest.NewRecorder()
+	get(w, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+	assert.Equal(t, "db-conn", w.Header().Get("deps"))
+
+	assert.NotNil(t, r["POST /users"])
+}
+
+func TestUsingRouterE(t *testing.T) {
+	h := testHandler{
+		Post:   dummyHandler,
+		Get:    dummyHandler,
+		Put:    dummyHandler,
+		Delete: dummyHandler,
+	}
+	r := make(testRouter)
+
+	err := UsingRouterE(r, &h)
+
+	assert.NoError(t, err)
+	assert.True(t, r["POST /users"])
+	assert.True(t, r["GET /users/{id}"])
+}
+
+func TestUsingRouterEErrors(t *testing.T) {
+	r := make(testRouter)
+
+	err := UsingRouterE(r, &failingHandler{Post: dummyHandler})
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrMalformedTag)
+
+	err = UsingRouterE(r, &testDuplicateController{First: dummyHandler, Second: dummyHandler})
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrDuplicateRoute)
+
+	var be *BindError
+	assert.ErrorAs(t, err, &be)
+	assert.Len(t, be.Unwrap(), 1)
+}
+
+func TestUsingRouterEStrict(t *testing.T) {
+	r := make(testRouter)
+
+	err := UsingRouterE(r, WithStrict(), &testEmptyController{})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrNoRoutes)
+}
+
+func TestUsingRoutersEUnknownRouter(t *testing.T) {
+	rs := map[string]Router{"router_a": make(testRouter)}
+
+	err := UsingRoutersE(rs, &testUnknownRouterController{Post: dummyHandler})
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnknownRouter)
+}
+
+func TestUsingRoutersEStrictUnusedRouter(t *testing.T) {
+	rs := map[string]Router{
+		"router_a": make(testRouter),
+		"router_b": make(testRouter),
+	}
+	h := testHandler{
+		Post:   dummyHandler,
+		Get:    dummyHandler,
+		Put:    dummyHandler,
+		Delete: dummyHandler,
+	}
+
+	err := UsingRoutersE(rs, WithStrict(), &h)
+
+	assert.Error(t, err)
+	assert.ErrorIs(t, err, ErrUnusedRouter)
+}
+
 func (r testRouter) Delete(pattern string, h http.HandlerFunc) {
 	r["DELETE "+pattern] = true
 }
@@ -150,6 +478,34 @@ func (r testRouter) Put(pattern string, h http.HandlerFunc) {
 	r["PUT "+pattern] = true
 }
 
+func (r capturingRouter) Delete(pattern string, h http.HandlerFunc) {
+	r["DELETE "+pattern] = h
+}
+
+func (r capturingRouter) Get(pattern string, h http.HandlerFunc) {
+	r["GET "+pattern] = h
+}
+
+func (r capturingRouter) Head(pattern string, h http.HandlerFunc) {
+	r["HEAD "+pattern] = h
+}
+
+func (r capturingRouter) Options(pattern string, h http.HandlerFunc) {
+	r["OPTIONS "+pattern] = h
+}
+
+func (r capturingRouter) Patch(pattern string, h http.HandlerFunc) {
+	r["PATCH "+pattern] = h
+}
+
+func (r capturingRouter) Post(pattern string, h http.HandlerFunc) {
+	r["POST "+pattern] = h
+}
+
+func (r capturingRouter) Put(pattern string, h http.HandlerFunc) {
+	r["PUT "+pattern] = h
+}
+
 func dummyHandler(w http.ResponseWriter, r *http.Request) {
 	// nothing ...
 }